@@ -0,0 +1,127 @@
+package abi
+
+import "encoding/json"
+
+// ABI is the parsed representation of a contract's JSON ABI.
+type ABI struct {
+	Constructor *Method
+	Methods     map[string]*Method
+	Events      map[string]*Event
+	Errors      map[string]*Error
+}
+
+// rawABIArg mirrors a single "inputs"/"outputs" entry of a JSON ABI, used
+// to unmarshal into an ArgumentStr tree that NewTypeFromArgument
+// understands and, for events, to read each input's indexed flag.
+type rawABIArg struct {
+	Name       string       `json:"name"`
+	Type       string       `json:"type"`
+	Indexed    bool         `json:"indexed"`
+	Components []*rawABIArg `json:"components"`
+}
+
+func (r *rawABIArg) toArgumentStr() *ArgumentStr {
+	res := &ArgumentStr{
+		Name: r.Name,
+		Type: r.Type,
+	}
+	for _, c := range r.Components {
+		res.Components = append(res.Components, c.toArgumentStr())
+	}
+	return res
+}
+
+func tupleType(args []*rawABIArg) (*Type, error) {
+	components := make([]*ArgumentStr, len(args))
+	for i, a := range args {
+		components[i] = a.toArgumentStr()
+	}
+	return NewTypeFromArgument(&ArgumentStr{Type: "tuple", Components: components})
+}
+
+// rawABIEntry is a single top-level entry of a JSON ABI array.
+type rawABIEntry struct {
+	Type            string       `json:"type"`
+	Name            string       `json:"name"`
+	Constant        bool         `json:"constant"`
+	StateMutability string       `json:"stateMutability"`
+	Anonymous       bool         `json:"anonymous"`
+	Inputs          []*rawABIArg `json:"inputs"`
+	Outputs         []*rawABIArg `json:"outputs"`
+}
+
+// NewABI parses a contract's JSON ABI.
+func NewABI(s string) (*ABI, error) {
+	var entries []*rawABIEntry
+	if err := json.Unmarshal([]byte(s), &entries); err != nil {
+		return nil, err
+	}
+
+	abi := &ABI{
+		Methods: map[string]*Method{},
+		Events:  map[string]*Event{},
+		Errors:  map[string]*Error{},
+	}
+
+	for _, entry := range entries {
+		switch entry.Type {
+		case "", "function":
+			inputs, err := tupleType(entry.Inputs)
+			if err != nil {
+				return nil, err
+			}
+			outputs, err := tupleType(entry.Outputs)
+			if err != nil {
+				return nil, err
+			}
+			method := &Method{
+				Name:    entry.Name,
+				Const:   entry.Constant || entry.StateMutability == "view" || entry.StateMutability == "pure",
+				Inputs:  inputs,
+				Outputs: outputs,
+			}
+			abi.Methods[entry.Name] = method
+
+		case "constructor":
+			inputs, err := tupleType(entry.Inputs)
+			if err != nil {
+				return nil, err
+			}
+			abi.Constructor = &Method{Inputs: inputs}
+
+		case "event":
+			inputs, err := tupleType(entry.Inputs)
+			if err != nil {
+				return nil, err
+			}
+			indexed := make([]bool, len(entry.Inputs))
+			for i, a := range entry.Inputs {
+				indexed[i] = a.Indexed
+			}
+			abi.Events[entry.Name] = &Event{
+				Name:      entry.Name,
+				Anonymous: entry.Anonymous,
+				Inputs:    inputs,
+				Indexed:   indexed,
+			}
+
+		case "error":
+			inputs, err := tupleType(entry.Inputs)
+			if err != nil {
+				return nil, err
+			}
+			abi.Errors[entry.Name] = &Error{
+				Name:   entry.Name,
+				Inputs: inputs,
+			}
+
+		case "fallback", "receive":
+			// no selector, nothing to index
+
+		default:
+			// ignore unknown entry types rather than failing the whole ABI
+		}
+	}
+
+	return abi, nil
+}
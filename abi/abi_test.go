@@ -0,0 +1,107 @@
+package abi
+
+import (
+	"math/big"
+	"testing"
+)
+
+const sampleABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+]`
+
+func TestNewABI(t *testing.T) {
+	a, err := NewABI(sampleABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, ok := a.Methods["transfer"]
+	if !ok {
+		t.Fatal("transfer method not found")
+	}
+	if sig := m.Sig(); sig != "transfer(address,uint256)" {
+		t.Fatalf("bad method signature: %s", sig)
+	}
+
+	e, ok := a.Events["Transfer"]
+	if !ok {
+		t.Fatal("Transfer event not found")
+	}
+	if !e.Indexed[0] || !e.Indexed[1] || e.Indexed[2] {
+		t.Fatalf("bad indexed flags: %v", e.Indexed)
+	}
+
+	errDef, ok := a.Errors["InsufficientBalance"]
+	if !ok {
+		t.Fatal("InsufficientBalance error not found")
+	}
+	if id := errDef.ID(); len(id) != 4 {
+		t.Fatalf("expected a 4-byte selector, got %x", id)
+	}
+}
+
+func TestDecodeRevertCustomError(t *testing.T) {
+	a, err := NewABI(sampleABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errDef := a.Errors["InsufficientBalance"]
+	input, err := Encode(map[string]interface{}{
+		"available": big.NewInt(1),
+		"required":  big.NewInt(2),
+	}, errDef.Inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append(append([]byte{}, errDef.ID()...), input...)
+
+	name, args, err := a.DecodeRevert(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "InsufficientBalance" {
+		t.Fatalf("bad error name: %s", name)
+	}
+	if args["available"].(*big.Int).Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("bad available: %v", args["available"])
+	}
+	if args["required"].(*big.Int).Cmp(big.NewInt(2)) != 0 {
+		t.Fatalf("bad required: %v", args["required"])
+	}
+}
+
+func TestDecodeRevertBuiltins(t *testing.T) {
+	a, err := NewABI(sampleABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Error(string) is encoded the same way as any other error/method
+	// argument list: as a tuple, not a bare string.
+	reasonData, err := Encode(map[string]interface{}{"reason": "not enough funds"}, MustNewType("tuple(string reason)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, args, err := a.DecodeRevert(append(append([]byte{}, errorStringSelector...), reasonData...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Error" || args["reason"].(string) != "not enough funds" {
+		t.Fatalf("bad Error(string) decode: %s %v", name, args)
+	}
+
+	codeData, err := Encode(big.NewInt(0x11), MustNewType("uint256"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, args, err = a.DecodeRevert(append(append([]byte{}, panicSelector...), codeData...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Panic" || args["code"].(*big.Int).Cmp(big.NewInt(0x11)) != 0 {
+		t.Fatalf("bad Panic(uint256) decode: %s %v", name, args)
+	}
+}
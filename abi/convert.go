@@ -0,0 +1,125 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/umbracle/ethgo"
+)
+
+// toBigInt does a best-effort conversion of v into a *big.Int, accepting
+// the native Go integer types, float64 (as produced by encoding/json),
+// decimal strings and 0x-prefixed hex strings, in addition to *big.Int
+// itself.
+func toBigInt(v interface{}) (*big.Int, error) {
+	switch x := v.(type) {
+	case *big.Int:
+		return x, nil
+	case big.Int:
+		return &x, nil
+	case int:
+		return big.NewInt(int64(x)), nil
+	case int8:
+		return big.NewInt(int64(x)), nil
+	case int16:
+		return big.NewInt(int64(x)), nil
+	case int32:
+		return big.NewInt(int64(x)), nil
+	case int64:
+		return big.NewInt(x), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(x)), nil
+	case uint8:
+		return big.NewInt(int64(x)), nil
+	case uint16:
+		return big.NewInt(int64(x)), nil
+	case uint32:
+		return big.NewInt(int64(x)), nil
+	case uint64:
+		return new(big.Int).SetUint64(x), nil
+	case float64:
+		return big.NewInt(int64(x)), nil
+	case string:
+		return parseBigInt(x)
+	case []byte:
+		return new(big.Int).SetBytes(x), nil
+	default:
+		return nil, fmt.Errorf("abi: cannot convert %T to a number", v)
+	}
+}
+
+func parseBigInt(s string) (*big.Int, error) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	var n *big.Int
+	var ok bool
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		n, ok = new(big.Int).SetString(s[2:], 16)
+	} else {
+		n, ok = new(big.Int).SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("abi: cannot parse %q as a number", s)
+	}
+	if neg {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+func toBool(v interface{}) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("abi: cannot convert %T to bool", v)
+	}
+	return b, nil
+}
+
+// toBytes does a best-effort conversion of v into a dynamic-length byte
+// slice, accepting []byte and hex strings.
+func toBytes(v interface{}) ([]byte, error) {
+	switch x := v.(type) {
+	case []byte:
+		return x, nil
+	case string:
+		return decodeHex(x)
+	default:
+		return nil, fmt.Errorf("abi: cannot convert %T to bytes", v)
+	}
+}
+
+// toFixedBytes is like toBytes but also accepts Go fixed-size byte arrays
+// ([N]byte), as produced by decoding a bytesN value.
+func toFixedBytes(v interface{}, size int) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		buf := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(buf), rv)
+		return buf, nil
+	}
+	return toBytes(v)
+}
+
+// toAddress does a best-effort conversion of v into an ethgo.Address,
+// accepting ethgo.Address and hex strings.
+func toAddress(v interface{}) (ethgo.Address, error) {
+	switch x := v.(type) {
+	case ethgo.Address:
+		return x, nil
+	case string:
+		return ethgo.HexToAddress(x), nil
+	default:
+		return ethgo.Address{}, fmt.Errorf("abi: cannot convert %T to an address", v)
+	}
+}
+
+func uint64ToWord(n uint64) []byte {
+	buf := make([]byte, 32)
+	for i := 0; i < 8; i++ {
+		buf[31-i] = byte(n >> (8 * i))
+	}
+	return buf
+}
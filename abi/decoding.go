@@ -0,0 +1,437 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/umbracle/ethgo"
+)
+
+// Decode ABI-decodes data as type t into a generic interface{} (a
+// map[string]interface{} for tuples, a native Go value for scalars).
+func Decode(t *Type, data []byte) (interface{}, error) {
+	return decode(t, data)
+}
+
+// Decode ABI-decodes data as t. If a previous call to t.Encode was given a
+// struct (or a pointer to one), the result is reconstructed as a pointer to
+// that same struct type instead of a generic map.
+//
+// t.Decode is the counterpart of t.Encode: if t is dynamic, data is expected
+// to start with the leading offset word t.Encode adds, rather than the
+// flat encoding the package-level Decode expects.
+func (t *Type) Decode(data []byte) (interface{}, error) {
+	if t.rtype != nil {
+		out := reflect.New(t.rtype)
+		if err := t.DecodeStruct(data, out.Interface()); err != nil {
+			return nil, err
+		}
+		return out.Interface(), nil
+	}
+	body, err := t.unwrap(data)
+	if err != nil {
+		return nil, err
+	}
+	return decode(t, body)
+}
+
+// DecodeStruct ABI-decodes data as t (which must be a tuple type) into v, a
+// pointer to a struct whose fields are matched by an `abi:"..."` tag or,
+// failing that, by case-insensitive field name.
+func (t *Type) DecodeStruct(data []byte, v interface{}) error {
+	if t.kind != KindTuple {
+		return fmt.Errorf("abi: DecodeStruct requires a tuple type, got %s", t.kind)
+	}
+
+	body, err := t.unwrap(data)
+	if err != nil {
+		return err
+	}
+	raw, err := decode(t, body)
+	if err != nil {
+		return err
+	}
+	values, ok := raw.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("abi: unexpected decode result %T", raw)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("abi: DecodeStruct expects a non-nil pointer, got %T", v)
+	}
+	elem := rv.Elem()
+	if elem.Kind() != reflect.Struct {
+		return fmt.Errorf("abi: DecodeStruct expects a pointer to a struct, got %T", v)
+	}
+	return assignMapToStruct(values, elem)
+}
+
+// unwrap strips the leading offset word t.Encode adds for a dynamic type,
+// returning data unchanged for a static type (which t.Encode never wraps).
+func (t *Type) unwrap(data []byte) ([]byte, error) {
+	if !isDynamicType(t) {
+		return data, nil
+	}
+	offsetWord, err := readWord(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	offset := new(big.Int).SetBytes(offsetWord)
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)) {
+		return nil, fmt.Errorf("abi: offset out of bounds")
+	}
+	return data[offset.Uint64():], nil
+}
+
+func decode(t *Type, data []byte) (interface{}, error) {
+	switch t.kind {
+	case KindBool:
+		word, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		return word[31] != 0, nil
+
+	case KindUInt:
+		word, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		return shrinkUint(new(big.Int).SetBytes(word), t.size), nil
+
+	case KindInt:
+		word, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		return shrinkInt(decodeSignedBigInt(word), t.size), nil
+
+	case KindAddress:
+		word, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		var addr ethgo.Address
+		copy(addr[:], word[32-len(addr):])
+		return addr, nil
+
+	case KindFixedBytes:
+		word, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		arr := reflect.New(reflect.ArrayOf(t.size, reflect.TypeOf(byte(0)))).Elem()
+		reflect.Copy(arr, reflect.ValueOf(word[:t.size]))
+		return arr.Interface(), nil
+
+	case KindFunction:
+		word, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		arr := reflect.New(reflect.ArrayOf(24, reflect.TypeOf(byte(0)))).Elem()
+		reflect.Copy(arr, reflect.ValueOf(word[:24]))
+		return arr.Interface(), nil
+
+	case KindString:
+		b, err := decodeDynamicBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+
+	case KindBytes:
+		return decodeDynamicBytes(data)
+
+	case KindSlice:
+		lenWord, err := readWord(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		n, err := wordToLength(lenWord, len(data)-32)
+		if err != nil {
+			return nil, err
+		}
+
+		types := make([]*Type, n)
+		for i := range types {
+			types[i] = t.elem
+		}
+		values, err := decodeElements(types, data[32:])
+		if err != nil {
+			return nil, err
+		}
+
+		slice := reflect.MakeSlice(reflect.SliceOf(goType(t.elem)), n, n)
+		for i, val := range values {
+			slice.Index(i).Set(reflect.ValueOf(val))
+		}
+		return slice.Interface(), nil
+
+	case KindArray:
+		types := make([]*Type, t.size)
+		for i := range types {
+			types[i] = t.elem
+		}
+		values, err := decodeElements(types, data)
+		if err != nil {
+			return nil, err
+		}
+
+		arr := reflect.New(reflect.ArrayOf(t.size, goType(t.elem))).Elem()
+		for i, val := range values {
+			arr.Index(i).Set(reflect.ValueOf(val))
+		}
+		return arr.Interface(), nil
+
+	case KindTuple:
+		types := make([]*Type, len(t.tuple))
+		for i, elem := range t.tuple {
+			types[i] = elem.Elem
+		}
+		values, err := decodeElements(types, data)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make(map[string]interface{}, len(t.tuple))
+		for i, elem := range t.tuple {
+			res[elem.Name] = values[i]
+		}
+		return res, nil
+
+	default:
+		return nil, fmt.Errorf("abi: decoding not supported for %s", t.kind)
+	}
+}
+
+// decodeElements is the decode-side counterpart of encodeElements: static
+// elements are read inline from the head, dynamic elements are read from
+// the offset (relative to the start of this region) stored in the head.
+// Every arithmetic bound below is checked explicitly rather than trusting
+// the input, since data is attacker-controlled ABI-decoded input.
+func decodeElements(types []*Type, data []byte) ([]interface{}, error) {
+	n := len(types)
+	res := make([]interface{}, n)
+	headOffsets := make([]int, n)
+
+	headOffset := 0
+	for i, t := range types {
+		headOffsets[i] = headOffset
+		if isDynamicType(t) {
+			headOffset += 32
+			continue
+		}
+		sz, err := staticSize(t)
+		if err != nil {
+			return nil, err
+		}
+		headOffset += sz
+	}
+
+	for i, t := range types {
+		if !isDynamicType(t) {
+			sz, err := staticSize(t)
+			if err != nil {
+				return nil, err
+			}
+			word, err := readRange(data, headOffsets[i], sz)
+			if err != nil {
+				return nil, err
+			}
+			val, err := decode(t, word)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = val
+			continue
+		}
+
+		offsetWord, err := readWord(data, headOffsets[i])
+		if err != nil {
+			return nil, err
+		}
+		offset := new(big.Int).SetBytes(offsetWord)
+		if !offset.IsUint64() || offset.Uint64() > uint64(len(data)) {
+			return nil, fmt.Errorf("abi: offset out of bounds")
+		}
+		val, err := decode(t, data[offset.Uint64():])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = val
+	}
+	return res, nil
+}
+
+// staticSize returns the byte size of t's encoding, for a t that is known
+// not to be dynamic (i.e. isDynamicType(t) is false).
+func staticSize(t *Type) (int, error) {
+	switch t.kind {
+	case KindArray:
+		elemSize, err := staticSize(t.elem)
+		if err != nil {
+			return 0, err
+		}
+		return t.size * elemSize, nil
+	case KindTuple:
+		total := 0
+		for _, elem := range t.tuple {
+			sz, err := staticSize(elem.Elem)
+			if err != nil {
+				return 0, err
+			}
+			total += sz
+		}
+		return total, nil
+	default:
+		return 32, nil
+	}
+}
+
+// readWord reads a bounds-checked 32-byte word at offset, returning an
+// error instead of panicking on truncated or malformed input.
+func readWord(data []byte, offset int) ([]byte, error) {
+	return readRange(data, offset, 32)
+}
+
+func readRange(data []byte, offset, size int) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, fmt.Errorf("abi: negative offset or size")
+	}
+	end := offset + size
+	if end < offset || end > len(data) {
+		return nil, fmt.Errorf("abi: read out of bounds (offset %d, size %d, data len %d)", offset, size, len(data))
+	}
+	return data[offset:end], nil
+}
+
+// wordToLength interprets a 32-byte length prefix, rejecting lengths that
+// could not possibly fit in the remaining data (which also guards against
+// the int overflow a naive int(n) conversion of an attacker-controlled
+// length would risk).
+func wordToLength(word []byte, maxElems int) (int, error) {
+	n := new(big.Int).SetBytes(word)
+	if !n.IsUint64() || n.Uint64() > uint64(maxElems) {
+		return 0, fmt.Errorf("abi: length out of bounds")
+	}
+	return int(n.Uint64()), nil
+}
+
+func decodeDynamicBytes(data []byte) ([]byte, error) {
+	lenWord, err := readWord(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	length := new(big.Int).SetBytes(lenWord)
+	if !length.IsUint64() || length.Uint64() > uint64(len(data)) {
+		return nil, fmt.Errorf("abi: bytes/string length out of bounds")
+	}
+	return readRange(data, 32, int(length.Uint64()))
+}
+
+func decodeSignedBigInt(word []byte) *big.Int {
+	n := new(big.Int).SetBytes(word)
+	if len(word) > 0 && word[0]&0x80 != 0 {
+		mod := new(big.Int).Lsh(big.NewInt(1), 256)
+		n.Sub(n, mod)
+	}
+	return n
+}
+
+func shrinkUint(n *big.Int, size int) interface{} {
+	switch size {
+	case 8:
+		return uint8(n.Uint64())
+	case 16:
+		return uint16(n.Uint64())
+	case 32:
+		return uint32(n.Uint64())
+	case 64:
+		return n.Uint64()
+	default:
+		return normalizeBigInt(n)
+	}
+}
+
+func shrinkInt(n *big.Int, size int) interface{} {
+	switch size {
+	case 8:
+		return int8(n.Int64())
+	case 16:
+		return int16(n.Int64())
+	case 32:
+		return int32(n.Int64())
+	case 64:
+		return n.Int64()
+	default:
+		return normalizeBigInt(n)
+	}
+}
+
+// normalizeBigInt rebuilds a zero-valued n as big.NewInt(0), so that decoded
+// zeroes compare equal under reflect.DeepEqual to values built the usual
+// way. big.Int.SetBytes on an all-zero input leaves its internal word
+// slice non-nil (only trimmed to length 0), whereas big.NewInt(0) leaves it
+// nil; the two are numerically identical but not DeepEqual.
+func normalizeBigInt(n *big.Int) *big.Int {
+	if n.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	return n
+}
+
+// goType returns the Go type used to represent a decoded value of t, for
+// use when reflect needs to allocate a slice/array of elements of t.
+func goType(t *Type) reflect.Type {
+	switch t.kind {
+	case KindBool:
+		return reflect.TypeOf(false)
+	case KindUInt:
+		switch t.size {
+		case 8:
+			return reflect.TypeOf(uint8(0))
+		case 16:
+			return reflect.TypeOf(uint16(0))
+		case 32:
+			return reflect.TypeOf(uint32(0))
+		case 64:
+			return reflect.TypeOf(uint64(0))
+		default:
+			return reflect.TypeOf((*big.Int)(nil))
+		}
+	case KindInt:
+		switch t.size {
+		case 8:
+			return reflect.TypeOf(int8(0))
+		case 16:
+			return reflect.TypeOf(int16(0))
+		case 32:
+			return reflect.TypeOf(int32(0))
+		case 64:
+			return reflect.TypeOf(int64(0))
+		default:
+			return reflect.TypeOf((*big.Int)(nil))
+		}
+	case KindString:
+		return reflect.TypeOf("")
+	case KindBytes:
+		return reflect.TypeOf([]byte(nil))
+	case KindFixedBytes:
+		return reflect.ArrayOf(t.size, reflect.TypeOf(byte(0)))
+	case KindAddress:
+		return reflect.TypeOf(ethgo.Address{})
+	case KindFunction:
+		return reflect.ArrayOf(24, reflect.TypeOf(byte(0)))
+	case KindSlice:
+		return reflect.SliceOf(goType(t.elem))
+	case KindArray:
+		return reflect.ArrayOf(t.size, goType(t.elem))
+	case KindTuple:
+		return reflect.TypeOf(map[string]interface{}(nil))
+	default:
+		return reflect.TypeOf((*interface{})(nil)).Elem()
+	}
+}
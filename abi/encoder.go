@@ -0,0 +1,218 @@
+package abi
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Encoder writes ABI-encoded values directly to an io.Writer. Tuples,
+// arrays and slices are laid out in place (length, head words and offsets
+// written as 32-byte words, tails written after them) instead of being
+// built as per-element []byte slices and concatenated the way Encode does;
+// each scalar, string or bytes leaf is still encoded by Encode and written
+// as one buffer. For tuples with many dynamic elements (e.g. a struct
+// holding several bytes[] fields) this avoids the repeated allocation and
+// copying that concatenation causes.
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an Encoder that streams its output to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// EncodeValue encodes v as type t and writes it to the underlying writer.
+// Composite types (tuple, array, slice) are encoded by pre-computing the
+// size of every element with sizeOf and then writing the head and tail
+// sections in a single pass; other types fall back to the existing Encode.
+func (e *Encoder) EncodeValue(t *Type, v interface{}) error {
+	switch t.kind {
+	case KindTuple:
+		return e.encodeTuple(t, v)
+	case KindArray, KindSlice:
+		return e.encodeList(t, v)
+	default:
+		buf, err := Encode(v, t)
+		if err != nil {
+			return err
+		}
+		_, err = e.w.Write(buf)
+		return err
+	}
+}
+
+func (e *Encoder) encodeTuple(t *Type, v interface{}) error {
+	fields, err := tupleFields(t, v)
+	if err != nil {
+		return err
+	}
+	return e.encodeHeadTail(len(t.tuple), func(i int) (*Type, interface{}) {
+		elem := t.tuple[i]
+		return elem.Elem, fields[elem.Name]
+	})
+}
+
+func (e *Encoder) encodeList(t *Type, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return fmt.Errorf("abi: expected slice or array to encode %s, got %T", t.kind, v)
+	}
+
+	if t.kind == KindSlice {
+		if err := writeUint256(e.w, uint64(rv.Len())); err != nil {
+			return err
+		}
+	}
+	return e.encodeHeadTail(rv.Len(), func(i int) (*Type, interface{}) {
+		return t.elem, rv.Index(i).Interface()
+	})
+}
+
+// encodeHeadTail writes n elements (fetched lazily via at) as a single
+// head/tail region: static elements are written inline in the head, dynamic
+// elements are written as a 32-byte offset in the head followed by their
+// contents appended to the tail, mirroring the standard ABI tuple layout.
+func (e *Encoder) encodeHeadTail(n int, at func(i int) (*Type, interface{})) error {
+	sizes := make([]int, n)
+	dynamic := make([]bool, n)
+	headSize := 0
+	for i := 0; i < n; i++ {
+		elemType, elemVal := at(i)
+		dynamic[i] = isDynamicType(elemType)
+		if dynamic[i] {
+			headSize += 32
+			continue
+		}
+		sz, err := sizeOf(elemType, elemVal)
+		if err != nil {
+			return err
+		}
+		sizes[i] = sz
+		headSize += sz
+	}
+
+	offset := headSize
+	for i := 0; i < n; i++ {
+		elemType, elemVal := at(i)
+		if !dynamic[i] {
+			if err := e.EncodeValue(elemType, elemVal); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeUint256(e.w, uint64(offset)); err != nil {
+			return err
+		}
+		sz, err := sizeOf(elemType, elemVal)
+		if err != nil {
+			return err
+		}
+		sizes[i] = sz
+		offset += sz
+	}
+
+	for i := 0; i < n; i++ {
+		if !dynamic[i] {
+			continue
+		}
+		elemType, elemVal := at(i)
+		if err := e.EncodeValue(elemType, elemVal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncodedSize returns the number of bytes Encode(v, t) (or an Encoder
+// encoding the same value) would produce, so callers can pre-size buffers
+// without encoding twice.
+func (t *Type) EncodedSize(v interface{}) (int, error) {
+	return sizeOf(t, v)
+}
+
+// sizeOf is the lightweight walk EncodeValue and EncodedSize use to learn
+// how many bytes a value will take up, without materializing that encoding.
+func sizeOf(t *Type, v interface{}) (int, error) {
+	switch t.kind {
+	case KindString:
+		s, ok := v.(string)
+		if !ok {
+			return 0, fmt.Errorf("abi: expected string, got %T", v)
+		}
+		return 32 + ceil32(len(s)), nil
+
+	case KindBytes:
+		b, err := toBytes(v)
+		if err != nil {
+			return 0, err
+		}
+		return 32 + ceil32(len(b)), nil
+
+	case KindTuple:
+		fields, err := tupleFields(t, v)
+		if err != nil {
+			return 0, err
+		}
+		total := 0
+		for _, elem := range t.tuple {
+			sz, err := sizeOf(elem.Elem, fields[elem.Name])
+			if err != nil {
+				return 0, err
+			}
+			if isDynamicType(elem.Elem) {
+				total += 32 + sz
+			} else {
+				total += sz
+			}
+		}
+		return total, nil
+
+	case KindArray, KindSlice:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return 0, fmt.Errorf("abi: expected slice or array, got %T", v)
+		}
+		total := 0
+		if t.kind == KindSlice {
+			total += 32 // length word
+		}
+		elemDynamic := isDynamicType(t.elem)
+		for i := 0; i < rv.Len(); i++ {
+			sz, err := sizeOf(t.elem, rv.Index(i).Interface())
+			if err != nil {
+				return 0, err
+			}
+			if elemDynamic {
+				total += 32 + sz
+			} else {
+				total += sz
+			}
+		}
+		return total, nil
+
+	default:
+		// every other kind (bool, int/uint of any width, address,
+		// fixed bytesN, function) occupies exactly one 32-byte word.
+		return 32, nil
+	}
+}
+
+func ceil32(n int) int {
+	return ((n + 31) / 32) * 32
+}
+
+func writeUint256(w io.Writer, n uint64) error {
+	var buf [32]byte
+	buf[24] = byte(n >> 56)
+	buf[25] = byte(n >> 48)
+	buf[26] = byte(n >> 40)
+	buf[27] = byte(n >> 32)
+	buf[28] = byte(n >> 24)
+	buf[29] = byte(n >> 16)
+	buf[30] = byte(n >> 8)
+	buf[31] = byte(n)
+	_, err := w.Write(buf[:])
+	return err
+}
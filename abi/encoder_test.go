@@ -0,0 +1,74 @@
+package abi
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/umbracle/ethgo"
+)
+
+// TestEncoderMatchesEncode exercises Encoder/EncodedSize against the same
+// struct as TestEncodingStructDynamic (a tuple with several bytes[]
+// fields), checking that the streaming encoder produces byte-for-byte the
+// same output as Encode, and that EncodedSize predicts its length exactly.
+func TestEncoderMatchesEncode(t *testing.T) {
+	typ := MustNewType("tuple(string A, address B, uint256 C, bytes[] D, bytes[] E, address[] F, int256 G)")
+
+	type Abcdefg struct {
+		A string
+		B ethgo.Address
+		C *big.Int
+		D [][]byte
+		E [][]byte
+		F []ethgo.Address
+		G *big.Int
+	}
+
+	a := &Abcdefg{
+		A: "submitKeygen(bytes)",
+		B: ethgo.HexToAddress("0xa16E02E87b7454126E5E10d957A927A7F5B5d2be"),
+		C: big.NewInt(4),
+		D: [][]byte{
+			mustDecodeHex("0x0102"),
+			mustDecodeHex("0x030405"),
+		},
+		E: [][]byte{},
+		F: []ethgo.Address{},
+		G: big.NewInt(0),
+	}
+
+	// Encoder mirrors the package-level Encode (the flat, "argument list"
+	// encoding used for e.g. method calldata), not the t.Encode method
+	// (which wraps a dynamic top-level value in a leading offset word).
+	want, err := Encode(a, typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size, err := typ.EncodedSize(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != len(want) {
+		t.Fatalf("EncodedSize returned %d, Encode produced %d bytes", size, len(want))
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).EncodeValue(typ, a); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("Encoder output does not match Encode:\ngot:  %x\nwant: %x", buf.Bytes(), want)
+	}
+
+	var b Abcdefg
+	decoded, err := Decode(typ, buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := assignMapToStruct(decoded.(map[string]interface{}), reflect.ValueOf(&b).Elem()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,306 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// Encode ABI-encodes v as type t.
+func Encode(v interface{}, t *Type) ([]byte, error) {
+	return encode(t, v)
+}
+
+// Encode ABI-encodes v as type t, remembering v's concrete Go struct type
+// (if any) so that a later call to t.Decode can reconstruct that same
+// struct instead of a generic map[string]interface{}.
+//
+// Unlike the package-level Encode (used for flat argument lists, e.g. a
+// method's calldata), t.Encode treats t as a single standalone value: if t
+// is itself dynamic, the result is prefixed with a leading offset word,
+// matching how a dynamic value is encoded when it appears as one element
+// rather than as the top-level argument list.
+func (t *Type) Encode(v interface{}) ([]byte, error) {
+	t.rememberType(v)
+	body, err := encode(t, v)
+	if err != nil {
+		return nil, err
+	}
+	if !isDynamicType(t) {
+		return body, nil
+	}
+	return append(uint64ToWord(32), body...), nil
+}
+
+func (t *Type) rememberType(v interface{}) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Struct {
+		t.rtype = rv.Type()
+	}
+}
+
+func encode(t *Type, v interface{}) ([]byte, error) {
+	switch t.kind {
+	case KindBool:
+		b, err := toBool(v)
+		if err != nil {
+			return nil, err
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+
+	case KindUInt:
+		n, err := toBigInt(v)
+		if err != nil {
+			return nil, err
+		}
+		if n.Sign() < 0 {
+			return nil, fmt.Errorf("abi: cannot encode negative value %s as %s", n, t.kind)
+		}
+		return padBigInt(n), nil
+
+	case KindInt:
+		n, err := toBigInt(v)
+		if err != nil {
+			return nil, err
+		}
+		return encodeSignedBigInt(n), nil
+
+	case KindAddress:
+		addr, err := toAddress(v)
+		if err != nil {
+			return nil, err
+		}
+		word := make([]byte, 32)
+		copy(word[32-len(addr):], addr[:])
+		return word, nil
+
+	case KindFixedBytes:
+		b, err := toFixedBytes(v, t.size)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 32 {
+			return nil, fmt.Errorf("abi: value too large for %s", canonicalType(t))
+		}
+		word := make([]byte, 32)
+		copy(word, b)
+		return word, nil
+
+	case KindFunction:
+		b, err := toFixedBytes(v, 24)
+		if err != nil {
+			return nil, err
+		}
+		word := make([]byte, 32)
+		copy(word, b)
+		return word, nil
+
+	case KindString:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("abi: cannot encode %T as string", v)
+		}
+		return encodeDynamicBytes([]byte(s)), nil
+
+	case KindBytes:
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return encodeDynamicBytes(b), nil
+
+	case KindSlice:
+		rv, err := sliceValue(v)
+		if err != nil {
+			return nil, err
+		}
+		n := rv.Len()
+		elemTypes := make([]*Type, n)
+		values := make([]interface{}, n)
+		for i := 0; i < n; i++ {
+			elemTypes[i] = t.elem
+			values[i] = rv.Index(i).Interface()
+		}
+		body, err := encodeElements(elemTypes, values)
+		if err != nil {
+			return nil, err
+		}
+		return append(uint64ToWord(uint64(n)), body...), nil
+
+	case KindArray:
+		rv, err := sliceValue(v)
+		if err != nil {
+			return nil, err
+		}
+		if rv.Len() != t.size {
+			return nil, fmt.Errorf("abi: expected array of length %d, got %d", t.size, rv.Len())
+		}
+		elemTypes := make([]*Type, t.size)
+		values := make([]interface{}, t.size)
+		for i := 0; i < t.size; i++ {
+			elemTypes[i] = t.elem
+			values[i] = rv.Index(i).Interface()
+		}
+		return encodeElements(elemTypes, values)
+
+	case KindTuple:
+		fields, err := tupleFields(t, v)
+		if err != nil {
+			return nil, err
+		}
+		elemTypes := make([]*Type, len(t.tuple))
+		values := make([]interface{}, len(t.tuple))
+		for i, elem := range t.tuple {
+			elemTypes[i] = elem.Elem
+			values[i] = fields[elem.Name]
+		}
+		return encodeElements(elemTypes, values)
+
+	default:
+		return nil, fmt.Errorf("abi: encoding not supported for %s", t.kind)
+	}
+}
+
+// encodeElements encodes a list of heterogeneous values as the standard ABI
+// head/tail region, used for tuple fields and for array/slice elements
+// alike: static elements are written inline in the head, dynamic elements
+// are written as an offset (relative to the start of this region) in the
+// head with their contents appended to the tail.
+func encodeElements(types []*Type, values []interface{}) ([]byte, error) {
+	n := len(types)
+	heads := make([][]byte, n)
+	tails := make([][]byte, n)
+
+	headSize := 0
+	for i := 0; i < n; i++ {
+		if isDynamicType(types[i]) {
+			headSize += 32
+			continue
+		}
+		enc, err := encode(types[i], values[i])
+		if err != nil {
+			return nil, err
+		}
+		heads[i] = enc
+		headSize += len(enc)
+	}
+
+	offset := headSize
+	for i := 0; i < n; i++ {
+		if !isDynamicType(types[i]) {
+			continue
+		}
+		enc, err := encode(types[i], values[i])
+		if err != nil {
+			return nil, err
+		}
+		heads[i] = uint64ToWord(uint64(offset))
+		tails[i] = enc
+		offset += len(enc)
+	}
+
+	var buf []byte
+	for _, h := range heads {
+		buf = append(buf, h...)
+	}
+	for _, tl := range tails {
+		buf = append(buf, tl...)
+	}
+	return buf, nil
+}
+
+// isDynamicType reports whether t's ABI encoding has a variable length and
+// therefore needs a head/tail split rather than being written inline.
+func isDynamicType(t *Type) bool {
+	switch t.kind {
+	case KindString, KindBytes, KindSlice:
+		return true
+	case KindArray:
+		return isDynamicType(t.elem)
+	case KindTuple:
+		for _, elem := range t.tuple {
+			if isDynamicType(elem.Elem) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// tupleFields normalizes v (a map[string]interface{}, a struct, or a
+// pointer to either) into a map keyed by t's tuple field names.
+func tupleFields(t *Type, v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	if m, ok := rv.Interface().(map[string]interface{}); ok {
+		return m, nil
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("abi: cannot encode %T as tuple", v)
+	}
+
+	res := map[string]interface{}{}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name := field.Tag.Get("abi")
+		if name == "" {
+			name = field.Name
+		}
+		for _, elem := range t.tuple {
+			if strings.EqualFold(elem.Name, name) {
+				res[elem.Name] = rv.Field(i).Interface()
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func sliceValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return reflect.Value{}, fmt.Errorf("abi: expected a slice or array, got %T", v)
+	}
+	return rv, nil
+}
+
+func encodeDynamicBytes(b []byte) []byte {
+	buf := uint64ToWord(uint64(len(b)))
+	buf = append(buf, b...)
+	if pad := len(b) % 32; pad != 0 {
+		buf = append(buf, make([]byte, 32-pad)...)
+	}
+	return buf
+}
+
+func padBigInt(n *big.Int) []byte {
+	buf := make([]byte, 32)
+	b := n.Bytes()
+	copy(buf[32-len(b):], b)
+	return buf
+}
+
+// encodeSignedBigInt encodes n as a 256-bit two's complement big-endian
+// word, matching Solidity's intN representation for negative values.
+func encodeSignedBigInt(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		return padBigInt(n)
+	}
+	mod := new(big.Int).Lsh(big.NewInt(1), 256)
+	return padBigInt(new(big.Int).Add(mod, n))
+}
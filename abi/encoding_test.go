@@ -24,299 +24,304 @@ func mustDecodeHex(str string) []byte {
 	return buf
 }
 
-func TestEncoding(t *testing.T) {
-	cases := []struct {
-		Type  string
-		Input interface{}
-	}{
-		{
-			"uint40",
-			big.NewInt(50),
-		},
-		{
-			"int256",
-			big.NewInt(2),
-		},
-		{
-			"int256[]",
-			[]*big.Int{big.NewInt(1), big.NewInt(2)},
-		},
-		{
-			"int256",
-			big.NewInt(-10),
-		},
-		{
-			"bytes5",
-			[5]byte{0x1, 0x2, 0x3, 0x4, 0x5},
-		},
-		{
-			"bytes",
-			mustDecodeHex("0x12345678911121314151617181920211"),
-		},
-		{
-			"string",
-			"foobar",
-		},
-		{
-			"uint8[][2]",
-			[2][]uint8{{1}, {1}},
-		},
-		{
-			"address[]",
-			[]ethgo.Address{{1}, {2}},
-		},
-		{
-			"bytes10[]",
-			[][10]byte{
-				{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0x10},
-				{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0x10},
-			},
-		},
-		{
-			"bytes[]",
-			[][]byte{
-				mustDecodeHex("0x11"),
-				mustDecodeHex("0x22"),
-			},
-		},
-		{
-			"uint32[2][3][4]",
-			[4][3][2]uint32{{{1, 2}, {3, 4}, {5, 6}}, {{7, 8}, {9, 10}, {11, 12}}, {{13, 14}, {15, 16}, {17, 18}}, {{19, 20}, {21, 22}, {23, 24}}},
-		},
-		{
-			"uint8[]",
-			[]uint8{1, 2},
-		},
-		{
-			"string[]",
-			[]string{"hello", "foobar"},
-		},
-		{
-			"string[2]",
-			[2]string{"hello", "foobar"},
-		},
-		{
-			"bytes32[][]",
-			[][][32]uint8{{{1}, {2}}, {{3}, {4}, {5}}},
-		},
-		{
-			"bytes32[][2]",
-			[2][][32]uint8{{{1}, {2}}, {{3}, {4}, {5}}},
-		},
-		{
-			"bytes32[3][2]",
-			[2][3][32]uint8{{{1}, {2}, {3}}, {{3}, {4}, {5}}},
-		},
-		{
-			"uint16[][2][]",
-			[][2][]uint16{
-				{{0, 1}, {2, 3}},
-				{{4, 5}, {6, 7}},
-			},
-		},
-		{
-			"tuple(bytes[] a)",
-			map[string]interface{}{
-				"a": [][]byte{{0xf0, 0xf0, 0xf0}, {0xf0, 0xf0, 0xf0}},
-			},
-		},
-		{
-			"tuple(uint32[2][][] a)",
-			// `[{"type": "uint32[2][][]"}]`,
-			map[string]interface{}{
-				"a": [][][2]uint32{{{uint32(1), uint32(200)}, {uint32(1), uint32(1000)}}, {{uint32(1), uint32(200)}, {uint32(1), uint32(1000)}}},
-			},
-		},
-		{
-			"tuple(uint64[2] a)",
-			map[string]interface{}{
-				"a": [2]uint64{1, 2},
-			},
-		},
-		{
-			"tuple(uint32[2][3][4] a)",
-			map[string]interface{}{
-				"a": [4][3][2]uint32{{{1, 2}, {3, 4}, {5, 6}}, {{7, 8}, {9, 10}, {11, 12}}, {{13, 14}, {15, 16}, {17, 18}}, {{19, 20}, {21, 22}, {23, 24}}},
-			},
-		},
-		{
-			"tuple(int32[] a)",
-			map[string]interface{}{
-				"a": []int32{1, 2},
-			},
-		},
-		{
-			"tuple(int32 a, int32 b)",
-			map[string]interface{}{
+// encodingCases is the set of type/value pairs exercised by TestEncoding,
+// covering static, dynamic, array, slice and (nested) tuple shapes. It is
+// also shared with FuzzDecode, which seeds its corpus with the encoded form
+// of every case here.
+var encodingCases = []struct {
+	Type  string
+	Input interface{}
+}{
+	{
+		"uint40",
+		big.NewInt(50),
+	},
+	{
+		"int256",
+		big.NewInt(2),
+	},
+	{
+		"int256[]",
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+	},
+	{
+		"int256",
+		big.NewInt(-10),
+	},
+	{
+		"bytes5",
+		[5]byte{0x1, 0x2, 0x3, 0x4, 0x5},
+	},
+	{
+		"bytes",
+		mustDecodeHex("0x12345678911121314151617181920211"),
+	},
+	{
+		"string",
+		"foobar",
+	},
+	{
+		"uint8[][2]",
+		[2][]uint8{{1}, {1}},
+	},
+	{
+		"address[]",
+		[]ethgo.Address{{1}, {2}},
+	},
+	{
+		"bytes10[]",
+		[][10]byte{
+			{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0x10},
+			{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0x10},
+		},
+	},
+	{
+		"bytes[]",
+		[][]byte{
+			mustDecodeHex("0x11"),
+			mustDecodeHex("0x22"),
+		},
+	},
+	{
+		"uint32[2][3][4]",
+		[4][3][2]uint32{{{1, 2}, {3, 4}, {5, 6}}, {{7, 8}, {9, 10}, {11, 12}}, {{13, 14}, {15, 16}, {17, 18}}, {{19, 20}, {21, 22}, {23, 24}}},
+	},
+	{
+		"uint8[]",
+		[]uint8{1, 2},
+	},
+	{
+		"string[]",
+		[]string{"hello", "foobar"},
+	},
+	{
+		"string[2]",
+		[2]string{"hello", "foobar"},
+	},
+	{
+		"bytes32[][]",
+		[][][32]uint8{{{1}, {2}}, {{3}, {4}, {5}}},
+	},
+	{
+		"bytes32[][2]",
+		[2][][32]uint8{{{1}, {2}}, {{3}, {4}, {5}}},
+	},
+	{
+		"bytes32[3][2]",
+		[2][3][32]uint8{{{1}, {2}, {3}}, {{3}, {4}, {5}}},
+	},
+	{
+		"uint16[][2][]",
+		[][2][]uint16{
+			{{0, 1}, {2, 3}},
+			{{4, 5}, {6, 7}},
+		},
+	},
+	{
+		"tuple(bytes[] a)",
+		map[string]interface{}{
+			"a": [][]byte{{0xf0, 0xf0, 0xf0}, {0xf0, 0xf0, 0xf0}},
+		},
+	},
+	{
+		"tuple(uint32[2][][] a)",
+		// `[{"type": "uint32[2][][]"}]`,
+		map[string]interface{}{
+			"a": [][][2]uint32{{{uint32(1), uint32(200)}, {uint32(1), uint32(1000)}}, {{uint32(1), uint32(200)}, {uint32(1), uint32(1000)}}},
+		},
+	},
+	{
+		"tuple(uint64[2] a)",
+		map[string]interface{}{
+			"a": [2]uint64{1, 2},
+		},
+	},
+	{
+		"tuple(uint32[2][3][4] a)",
+		map[string]interface{}{
+			"a": [4][3][2]uint32{{{1, 2}, {3, 4}, {5, 6}}, {{7, 8}, {9, 10}, {11, 12}}, {{13, 14}, {15, 16}, {17, 18}}, {{19, 20}, {21, 22}, {23, 24}}},
+		},
+	},
+	{
+		"tuple(int32[] a)",
+		map[string]interface{}{
+			"a": []int32{1, 2},
+		},
+	},
+	{
+		"tuple(int32 a, int32 b)",
+		map[string]interface{}{
+			"a": int32(1),
+			"b": int32(2),
+		},
+	},
+	{
+		"tuple(string a, int32 b)",
+		map[string]interface{}{
+			"a": "Hello Worldxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx",
+			"b": int32(2),
+		},
+	},
+	{
+		"tuple(int32[2] a, int32[] b)",
+		map[string]interface{}{
+			"a": [2]int32{1, 2},
+			"b": []int32{4, 5, 6},
+		},
+	},
+	{
+		// tuple with array slice
+		"tuple(address[] a)",
+		map[string]interface{}{
+			"a": []ethgo.Address{
+				{0x1},
+			},
+		},
+	},
+	{
+		// First dynamic second static
+		"tuple(int32[] a, int32[2] b)",
+		map[string]interface{}{
+			"a": []int32{1, 2, 3},
+			"b": [2]int32{4, 5},
+		},
+	},
+	{
+		// Both dynamic
+		"tuple(int32[] a, int32[] b)",
+		map[string]interface{}{
+			"a": []int32{1, 2, 3},
+			"b": []int32{4, 5, 6},
+		},
+	},
+	{
+		"tuple(string a, int64 b)",
+		map[string]interface{}{
+			"a": "hello World",
+			"b": int64(266),
+		},
+	},
+	{
+		// tuple array
+		"tuple(int32 a, int32 b)[2]",
+		[2]map[string]interface{}{
+			{
 				"a": int32(1),
 				"b": int32(2),
 			},
-		},
-		{
-			"tuple(string a, int32 b)",
-			map[string]interface{}{
-				"a": "Hello Worldxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx",
-				"b": int32(2),
-			},
-		},
-		{
-			"tuple(int32[2] a, int32[] b)",
-			map[string]interface{}{
-				"a": [2]int32{1, 2},
-				"b": []int32{4, 5, 6},
-			},
-		},
-		{
-			// tuple with array slice
-			"tuple(address[] a)",
-			map[string]interface{}{
-				"a": []ethgo.Address{
-					{0x1},
-				},
-			},
-		},
-		{
-			// First dynamic second static
-			"tuple(int32[] a, int32[2] b)",
-			map[string]interface{}{
-				"a": []int32{1, 2, 3},
-				"b": [2]int32{4, 5},
+			{
+				"a": int32(3),
+				"b": int32(4),
 			},
 		},
-		{
-			// Both dynamic
-			"tuple(int32[] a, int32[] b)",
-			map[string]interface{}{
+	},
+
+	{
+		// tuple array with dynamic content
+		"tuple(int32[] a)[2]",
+		[2]map[string]interface{}{
+			{
 				"a": []int32{1, 2, 3},
-				"b": []int32{4, 5, 6},
 			},
-		},
-		{
-			"tuple(string a, int64 b)",
-			map[string]interface{}{
-				"a": "hello World",
-				"b": int64(266),
+			{
+				"a": []int32{4, 5, 6},
 			},
 		},
-		{
-			// tuple array
-			"tuple(int32 a, int32 b)[2]",
-			[2]map[string]interface{}{
+	},
+	{
+		// tuple slice
+		"tuple(int32 a, int32[] b)[]",
+		[]map[string]interface{}{
+			{
+				"a": int32(1),
+				"b": []int32{2, 3},
+			},
+			{
+				"a": int32(4),
+				"b": []int32{5, 6},
+			},
+		},
+	},
+	{
+		// nested tuple
+		"tuple(tuple(int32 c, int32[] d) a, int32[] b)",
+		map[string]interface{}{
+			"a": map[string]interface{}{
+				"c": int32(5),
+				"d": []int32{3, 4},
+			},
+			"b": []int32{1, 2},
+		},
+	},
+	{
+		"tuple(uint8[2] a, tuple(uint8 e, uint32 f)[2] b, uint16 c, uint64[2][1] d)",
+		map[string]interface{}{
+			"a": [2]uint8{uint8(1), uint8(2)},
+			"b": [2]map[string]interface{}{
 				{
-					"a": int32(1),
-					"b": int32(2),
+					"e": uint8(10),
+					"f": uint32(11),
 				},
 				{
-					"a": int32(3),
-					"b": int32(4),
+					"e": uint8(20),
+					"f": uint32(21),
 				},
 			},
+			"c": uint16(3),
+			"d": [1][2]uint64{{uint64(4), uint64(5)}},
 		},
-
-		{
-			// tuple array with dynamic content
-			"tuple(int32[] a)[2]",
-			[2]map[string]interface{}{
-				{
-					"a": []int32{1, 2, 3},
-				},
+	},
+	{
+		"tuple(uint16 a, uint16 b)[1][]",
+		[][1]map[string]interface{}{
+			{
 				{
-					"a": []int32{4, 5, 6},
+					"a": uint16(1),
+					"b": uint16(2),
 				},
 			},
-		},
-		{
-			// tuple slice
-			"tuple(int32 a, int32[] b)[]",
-			[]map[string]interface{}{
+			{
 				{
-					"a": int32(1),
-					"b": []int32{2, 3},
+					"a": uint16(3),
+					"b": uint16(4),
 				},
+			},
+			{
 				{
-					"a": int32(4),
-					"b": []int32{5, 6},
+					"a": uint16(5),
+					"b": uint16(6),
 				},
 			},
-		},
-		{
-			// nested tuple
-			"tuple(tuple(int32 c, int32[] d) a, int32[] b)",
-			map[string]interface{}{
-				"a": map[string]interface{}{
-					"c": int32(5),
-					"d": []int32{3, 4},
+			{
+				{
+					"a": uint16(7),
+					"b": uint16(8),
 				},
-				"b": []int32{1, 2},
 			},
 		},
-		{
-			"tuple(uint8[2] a, tuple(uint8 e, uint32 f)[2] b, uint16 c, uint64[2][1] d)",
-			map[string]interface{}{
-				"a": [2]uint8{uint8(1), uint8(2)},
-				"b": [2]map[string]interface{}{
-					{
-						"e": uint8(10),
-						"f": uint32(11),
-					},
-					{
-						"e": uint8(20),
-						"f": uint32(21),
-					},
-				},
-				"c": uint16(3),
-				"d": [1][2]uint64{{uint64(4), uint64(5)}},
+	},
+	{
+		"tuple(uint64[][] a, tuple(uint8 a, uint32 b)[1] b, uint64 c)",
+		map[string]interface{}{
+			"a": [][]uint64{
+				{3, 4},
 			},
-		},
-		{
-			"tuple(uint16 a, uint16 b)[1][]",
-			[][1]map[string]interface{}{
-				{
-					{
-						"a": uint16(1),
-						"b": uint16(2),
-					},
-				},
-				{
-					{
-						"a": uint16(3),
-						"b": uint16(4),
-					},
-				},
-				{
-					{
-						"a": uint16(5),
-						"b": uint16(6),
-					},
-				},
+			"b": [1]map[string]interface{}{
 				{
-					{
-						"a": uint16(7),
-						"b": uint16(8),
-					},
+					"a": uint8(1),
+					"b": uint32(2),
 				},
 			},
+			"c": uint64(10),
 		},
-		{
-			"tuple(uint64[][] a, tuple(uint8 a, uint32 b)[1] b, uint64 c)",
-			map[string]interface{}{
-				"a": [][]uint64{
-					{3, 4},
-				},
-				"b": [1]map[string]interface{}{
-					{
-						"a": uint8(1),
-						"b": uint32(2),
-					},
-				},
-				"c": uint64(10),
-			},
-		},
-	}
+	},
+}
 
+func TestEncoding(t *testing.T) {
 	server := testutil.NewTestServer(t)
 
-	for _, c := range cases {
+	for _, c := range encodingCases {
+		c := c
 		t.Run("", func(t *testing.T) {
 			t.Parallel()
 
@@ -812,7 +817,7 @@ func TestEncodingStructDynamic(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if (res.(*Abcdefg)).G != big.NewInt(1) {
+	if (res.(*Abcdefg)).G.Cmp(big.NewInt(0)) != 0 {
 		t.Fatal("decoding not as expected for Abcdefg")
 	}
 }
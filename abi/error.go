@@ -0,0 +1,127 @@
+package abi
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Error describes a Solidity custom error, as emitted by an ABI entry of
+// type "error" (Solidity 0.8.4+). Revert data produced by a custom error is
+// the same shape as a method call: a 4-byte selector over the error's
+// canonical signature, followed by its ABI-encoded arguments.
+type Error struct {
+	Name   string
+	Inputs *Type
+}
+
+// ID returns the 4-byte selector of the error.
+func (e *Error) ID() []byte {
+	return keccak256([]byte(buildSignature(e.Name, e.Inputs)))[:4]
+}
+
+// builtin revert selectors that do not appear in any contract's ABI: the
+// compiler-generated require/revert(string) and the Panic(uint256) used for
+// assert failures, arithmetic overflow, out-of-bounds access, etc.
+var (
+	errorStringSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector       = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+// DecodeRevert decodes the return data of a failed call (e.g. from
+// eth_call) into the name of the error that was raised and its decoded
+// arguments. It recognizes the built-in Error(string) and Panic(uint256)
+// selectors as well as any user-defined error in a.Errors.
+func (a *ABI) DecodeRevert(data []byte) (string, map[string]interface{}, error) {
+	if len(data) < 4 {
+		return "", nil, fmt.Errorf("abi: revert data too short, expected at least 4 bytes, got %d", len(data))
+	}
+	selector, args := data[:4], data[4:]
+
+	switch {
+	case bytes.Equal(selector, errorStringSelector):
+		// Error(string), like any other error/method argument list, is
+		// ABI-encoded as a tuple: args starts with an offset word
+		// pointing at the string's length+data, not the length+data
+		// directly.
+		raw, err := Decode(MustNewType("tuple(string reason)"), args)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to decode Error(string) revert reason: %v", err)
+		}
+		return "Error", raw.(map[string]interface{}), nil
+
+	case bytes.Equal(selector, panicSelector):
+		code, err := Decode(MustNewType("uint256"), args)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to decode Panic(uint256) revert code: %v", err)
+		}
+		return "Panic", map[string]interface{}{"code": code}, nil
+	}
+
+	for name, errDef := range a.Errors {
+		if !bytes.Equal(errDef.ID(), selector) {
+			continue
+		}
+		raw, err := Decode(errDef.Inputs, args)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to decode error %s: %v", name, err)
+		}
+		return name, raw.(map[string]interface{}), nil
+	}
+
+	return "", nil, fmt.Errorf("abi: unknown revert selector 0x%x", selector)
+}
+
+// buildSignature renders a callable's canonical signature, e.g.
+// "InsufficientBalance(uint256,uint256)", as used to compute its selector.
+func buildSignature(name string, inputs *Type) string {
+	parts := make([]string, len(inputs.tuple))
+	for i, elem := range inputs.tuple {
+		parts[i] = canonicalType(elem.Elem)
+	}
+	return name + "(" + strings.Join(parts, ",") + ")"
+}
+
+// canonicalType renders t the way Solidity would in a function/error/event
+// signature, e.g. "uint256", "bytes32[2]" or "(uint256,address)" for a
+// tuple.
+func canonicalType(t *Type) string {
+	switch t.kind {
+	case KindBool:
+		return "bool"
+	case KindUInt:
+		return fmt.Sprintf("uint%d", t.size)
+	case KindInt:
+		return fmt.Sprintf("int%d", t.size)
+	case KindString:
+		return "string"
+	case KindBytes:
+		return "bytes"
+	case KindFixedBytes:
+		return fmt.Sprintf("bytes%d", t.size)
+	case KindAddress:
+		return "address"
+	case KindFunction:
+		return "function"
+	case KindSlice:
+		return canonicalType(t.elem) + "[]"
+	case KindArray:
+		return fmt.Sprintf("%s[%d]", canonicalType(t.elem), t.size)
+	case KindTuple:
+		parts := make([]string, len(t.tuple))
+		for i, elem := range t.tuple {
+			parts[i] = canonicalType(elem.Elem)
+		}
+		return "(" + strings.Join(parts, ",") + ")"
+	default:
+		return fmt.Sprintf("<kind %d>", t.kind)
+	}
+}
+
+func keccak256(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,186 @@
+package abi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/umbracle/ethgo"
+)
+
+// Event describes a smart contract event, as emitted by an ABI entry of
+// type "event".
+type Event struct {
+	Name      string
+	Anonymous bool
+	Inputs    *Type
+	// Indexed marks, in the same order as Inputs' tuple fields, which
+	// arguments are indexed (and therefore live in the log's topics
+	// rather than its data).
+	Indexed []bool
+}
+
+// Unpack decodes the non-indexed event data into a flat slice of values,
+// one per non-indexed input argument, mirroring go-ethereum's abi.Unpack.
+func (e *Event) Unpack(data []byte) ([]interface{}, error) {
+	_, nonIndexed := e.splitIndexed()
+	return unpackTuple(nonIndexed, data)
+}
+
+// UnpackInto decodes the non-indexed event data into v, which may be a
+// pointer to a struct, a pointer to a slice/array, or a pointer to a map.
+// See Method.UnpackInto for the exact matching rules.
+func (e *Event) UnpackInto(data []byte, v interface{}) error {
+	_, nonIndexed := e.splitIndexed()
+	return decodeInto(nonIndexed, data, v)
+}
+
+// ParseLog decodes log into a map keyed by argument name, combining the
+// non-indexed arguments (decoded from log.Data) with the indexed ones
+// (decoded from log.Topics). Indexed arguments of a dynamic type (string,
+// bytes, arrays, tuples) cannot be recovered from their topic, since the
+// topic only holds the Keccak256 hash of the original value; for those,
+// the raw hash is returned instead.
+func (e *Event) ParseLog(log *ethgo.Log) (map[string]interface{}, error) {
+	indexedArgs, nonIndexed := e.splitIndexed()
+
+	if err := e.validateTopics(log); err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+
+	if len(nonIndexed.tuple) != 0 {
+		values, err := Decode(nonIndexed, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode non-indexed arguments: %v", err)
+		}
+		for k, v := range values.(map[string]interface{}) {
+			res[k] = v
+		}
+	}
+
+	topicOffset := 1
+	if e.Anonymous {
+		topicOffset = 0
+	}
+	for i, elem := range indexedArgs {
+		topic := log.Topics[topicOffset+i]
+		val, err := decodeTopic(elem.Elem, topic)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode indexed argument %s: %v", elem.Name, err)
+		}
+		res[elem.Name] = val
+	}
+
+	return res, nil
+}
+
+// ParseLogInto decodes log the same way as ParseLog and reflects the
+// result into v, which may be a pointer to a struct (matched by field name
+// or an `abi:"..."` tag) or a pointer to a map[string]interface{}.
+func (e *Event) ParseLogInto(log *ethgo.Log, v interface{}) error {
+	values, err := e.ParseLog(log)
+	if err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("abi: ParseLogInto expects a non-nil pointer, got %T", v)
+	}
+	elem := rv.Elem()
+
+	switch elem.Kind() {
+	case reflect.Map:
+		elem.Set(reflect.ValueOf(values))
+		return nil
+
+	case reflect.Struct:
+		return assignMapToStruct(values, elem)
+
+	default:
+		return fmt.Errorf("abi: cannot unpack log into %s", elem.Kind())
+	}
+}
+
+// splitIndexed splits e.Inputs into the indexed fields (in event-definition
+// order, which is also topic order) and a tuple type holding only the
+// non-indexed fields (in the order they appear in log.Data).
+func (e *Event) splitIndexed() (indexed []*TupleElem, nonIndexed *Type) {
+	nonIndexed = &Type{kind: KindTuple}
+	for i, elem := range e.Inputs.tuple {
+		if i < len(e.Indexed) && e.Indexed[i] {
+			indexed = append(indexed, elem)
+		} else {
+			nonIndexed.tuple = append(nonIndexed.tuple, elem)
+		}
+	}
+	return
+}
+
+func (e *Event) validateTopics(log *ethgo.Log) error {
+	numIndexed := 0
+	for _, b := range e.Indexed {
+		if b {
+			numIndexed++
+		}
+	}
+	want := numIndexed
+	if !e.Anonymous {
+		want++
+	}
+	if len(log.Topics) != want {
+		return fmt.Errorf("event %s expects %d topics, log has %d", e.Name, want, len(log.Topics))
+	}
+	return nil
+}
+
+// decodeTopic decodes a single indexed argument of type t from a 32-byte
+// topic slot. Static types are decoded directly; dynamic types (string,
+// bytes, arrays, tuples) only have their Keccak256 hash available, which is
+// returned as-is.
+func decodeTopic(t *Type, topic ethgo.Hash) (interface{}, error) {
+	switch t.kind {
+	case KindString, KindBytes, KindSlice, KindArray, KindTuple:
+		return topic, nil
+	default:
+		wrapper := &Type{kind: KindTuple, tuple: []*TupleElem{{Name: "0", Elem: t}}}
+		res, err := Decode(wrapper, topic[:])
+		if err != nil {
+			return nil, err
+		}
+		return res.(map[string]interface{})["0"], nil
+	}
+}
+
+func assignMapToStruct(values map[string]interface{}, elem reflect.Value) error {
+	typ := elem.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := field.Tag.Get("abi")
+		if name == "" {
+			name = field.Name
+		}
+
+		val, ok := values[name]
+		if !ok {
+			for k, v := range values {
+				if strings.EqualFold(k, name) {
+					val, ok = v, true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		rv := reflect.ValueOf(val)
+		if !rv.Type().AssignableTo(field.Type) {
+			return fmt.Errorf("abi: cannot assign %s to field %s of type %s", rv.Type(), field.Name, field.Type)
+		}
+		elem.Field(i).Set(rv)
+	}
+	return nil
+}
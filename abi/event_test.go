@@ -0,0 +1,109 @@
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/umbracle/ethgo"
+)
+
+// TestEventParseLog covers an event with a mix of indexed and non-indexed
+// arguments, combining data decoded from the log's Data field with values
+// recovered from its Topics.
+func TestEventParseLog(t *testing.T) {
+	e := &Event{
+		Name:    "Transfer",
+		Inputs:  MustNewType("tuple(address from, address to, uint256 value)"),
+		Indexed: []bool{true, true, false},
+	}
+
+	from := ethgo.HexToAddress("0xa16E02E87b7454126E5E10d957A927A7F5B5d2be")
+	to := ethgo.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	data, err := Encode(map[string]interface{}{"value": big.NewInt(42)}, MustNewType("tuple(uint256 value)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log := &ethgo.Log{
+		Topics: []ethgo.Hash{
+			{}, // topic0, event signature hash, not checked here
+			addressTopic(from),
+			addressTopic(to),
+		},
+		Data: data,
+	}
+
+	res, err := e.ParseLog(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res["from"].(ethgo.Address) != from {
+		t.Fatalf("bad from: %v", res["from"])
+	}
+	if res["to"].(ethgo.Address) != to {
+		t.Fatalf("bad to: %v", res["to"])
+	}
+	if res["value"].(*big.Int).Cmp(big.NewInt(42)) != 0 {
+		t.Fatalf("bad value: %v", res["value"])
+	}
+}
+
+func TestEventParseLogInto(t *testing.T) {
+	e := &Event{
+		Name:    "Transfer",
+		Inputs:  MustNewType("tuple(address from, uint256 value)"),
+		Indexed: []bool{true, false},
+	}
+
+	from := ethgo.HexToAddress("0xa16E02E87b7454126E5E10d957A927A7F5B5d2be")
+	data, err := Encode(map[string]interface{}{"value": big.NewInt(7)}, MustNewType("tuple(uint256 value)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log := &ethgo.Log{
+		Topics: []ethgo.Hash{{}, addressTopic(from)},
+		Data:   data,
+	}
+
+	var out struct {
+		From  ethgo.Address
+		Value *big.Int
+	}
+	if err := e.ParseLogInto(log, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.From != from || out.Value.Cmp(big.NewInt(7)) != 0 {
+		t.Fatalf("bad parse into struct: %+v", out)
+	}
+}
+
+// TestUnpackIntoTypeMismatch exercises the case where a caller's
+// destination slice/array element type doesn't match what the ABI data
+// actually decodes to: UnpackInto must report an error, not panic.
+func TestUnpackIntoTypeMismatch(t *testing.T) {
+	m := &Method{
+		Name:    "values",
+		Outputs: MustNewType("tuple(uint256 a, string b)"),
+	}
+
+	encoded, err := Encode(map[string]interface{}{
+		"a": big.NewInt(1),
+		"b": "hello",
+	}, m.Outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out []int
+	if err := m.UnpackInto(encoded, &out); err == nil {
+		t.Fatal("expected an error unpacking into a mismatched element type, got nil")
+	}
+}
+
+func addressTopic(addr ethgo.Address) ethgo.Hash {
+	var h ethgo.Hash
+	copy(h[12:], addr[:])
+	return h
+}
@@ -0,0 +1,77 @@
+package abi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// FuzzDecode exercises Decode (and the Encode/Decode round trip) against
+// arbitrary type descriptors and arbitrary byte inputs, both mutated by the
+// fuzzer; the corpus is seeded with every encodingCases entry and with
+// random tuples whose field types are drawn by randomType. Unlike
+// testDecodePanic, which only ever flips a single byte to 0xff, the fuzzer
+// lets go-fuzz/testing.F mutate length prefixes, offsets and nesting depth
+// freely, which is what is needed to reach offset-driven quadratic reads
+// and self-referential offset cycles in nested dynamic tuples.
+func FuzzDecode(f *testing.F) {
+	for _, c := range encodingCases {
+		tt, err := NewType(c.Type)
+		if err != nil {
+			f.Fatalf("invalid seed type %s: %v", c.Type, err)
+		}
+		encoded, err := Encode(c.Input, tt)
+		if err != nil {
+			f.Fatalf("failed to encode seed %s: %v", c.Type, err)
+		}
+		f.Add(c.Type, encoded)
+	}
+	// seed a few random tuple shapes too, to cover depths/widths
+	// encodingCases doesn't.
+	for i := 0; i < 10; i++ {
+		n := randomInt(1, 4)
+		fields := make([]string, n)
+		for j := range fields {
+			fields[j] = fmt.Sprintf("%s arg%d", randomType(), j)
+		}
+		typ := "tuple(" + strings.Join(fields, ",") + ")"
+
+		tt, err := NewType(typ)
+		if err != nil {
+			continue
+		}
+		input := generateRandomType(tt)
+		encoded, err := Encode(input, tt)
+		if err != nil {
+			continue
+		}
+		f.Add(typ, encoded)
+	}
+
+	f.Fuzz(func(t *testing.T, typ string, data []byte) {
+		tt, err := NewType(typ)
+		if err != nil {
+			return // not a valid type descriptor, nothing to decode
+		}
+
+		decoded, err := Decode(tt, data)
+		if err != nil {
+			return // malformed input is expected to error, not panic
+		}
+
+		// round-trip invariant: re-encoding a successfully decoded value and
+		// decoding that back must reproduce an equal value.
+		reencoded, err := Encode(decoded, tt)
+		if err != nil {
+			t.Fatalf("re-encode of decoded value failed for type %s: %v", typ, err)
+		}
+		redecoded, err := Decode(tt, reencoded)
+		if err != nil {
+			t.Fatalf("re-decode of re-encoded value failed for type %s: %v", typ, err)
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Fatalf("round trip mismatch for type %s: %v != %v", typ, decoded, redecoded)
+		}
+	})
+}
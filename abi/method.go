@@ -0,0 +1,54 @@
+package abi
+
+// Method is a callable method of a smart contract, as described by an ABI
+// entry of type "function".
+type Method struct {
+	Name    string
+	Const   bool
+	Inputs  *Type
+	Outputs *Type
+}
+
+// Sig returns the method's canonical signature, e.g.
+// "transfer(address,uint256)".
+func (m *Method) Sig() string {
+	return buildSignature(m.Name, m.Inputs)
+}
+
+// ID returns the 4-byte selector of the method.
+func (m *Method) ID() []byte {
+	return keccak256([]byte(m.Sig()))[:4]
+}
+
+// Encode encodes v (a struct, map or slice matching m.Inputs) into call
+// data, prefixed with the method's 4-byte selector.
+func (m *Method) Encode(v interface{}) ([]byte, error) {
+	input, err := Encode(v, m.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	return append(m.ID(), input...), nil
+}
+
+// Decode decodes the return data of a call into a generic interface{}
+// (typically map[string]interface{} for tuple outputs).
+func (m *Method) Decode(data []byte) (interface{}, error) {
+	return Decode(m.Outputs, data)
+}
+
+// Unpack decodes the return data of a call into a flat slice of values, one
+// per output argument, mirroring go-ethereum's abi.Unpack. Use this when the
+// caller wants the raw positional results without reflecting them into a
+// user type.
+func (m *Method) Unpack(data []byte) ([]interface{}, error) {
+	return unpackTuple(m.Outputs, data)
+}
+
+// UnpackInto decodes the return data of a call into v, which may be a
+// pointer to a struct (matched by field name or an `abi:"..."` tag), a
+// pointer to a slice/array (for a single return value, or a positional
+// match across multiple return values), or a pointer to a map. This is the
+// equivalent of go-ethereum's abi.UnpackIntoInterface.
+func (m *Method) UnpackInto(data []byte, v interface{}) error {
+	return decodeInto(m.Outputs, data, v)
+}
@@ -0,0 +1,83 @@
+package abi
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestMethodUnpack(t *testing.T) {
+	m := &Method{
+		Name:    "balanceOf",
+		Outputs: MustNewType("tuple(uint256 balance, bool ok)"),
+	}
+
+	encoded, err := Encode(map[string]interface{}{
+		"balance": big.NewInt(100),
+		"ok":      true,
+	}, m.Outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := m.Unpack(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 {
+		t.Fatalf("expected 2 values, got %d", len(values))
+	}
+	if values[0].(*big.Int).Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("bad balance: %v", values[0])
+	}
+	if values[1].(bool) != true {
+		t.Fatalf("bad ok: %v", values[1])
+	}
+}
+
+func TestMethodUnpackInto(t *testing.T) {
+	m := &Method{
+		Name:    "balanceOf",
+		Outputs: MustNewType("tuple(uint256 balance, bool ok)"),
+	}
+
+	encoded, err := Encode(map[string]interface{}{
+		"balance": big.NewInt(100),
+		"ok":      true,
+	}, m.Outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		Balance *big.Int
+		Ok      bool
+	}
+	if err := m.UnpackInto(encoded, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Balance.Cmp(big.NewInt(100)) != 0 || !out.Ok {
+		t.Fatalf("bad unpack into struct: %+v", out)
+	}
+
+	var slice []interface{}
+	if err := m.UnpackInto(encoded, &slice); err != nil {
+		t.Fatal(err)
+	}
+	if len(slice) != 2 || slice[0].(*big.Int).Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("bad unpack into slice: %+v", slice)
+	}
+}
+
+func TestMethodSigAndID(t *testing.T) {
+	m := &Method{
+		Name:   "transfer",
+		Inputs: MustNewType("tuple(address to, uint256 amount)"),
+	}
+	if sig := m.Sig(); sig != "transfer(address,uint256)" {
+		t.Fatalf("bad signature: %s", sig)
+	}
+	if id := m.ID(); !reflect.DeepEqual(id, mustDecodeHex("0xa9059cbb")) {
+		t.Fatalf("bad selector: %x", id)
+	}
+}
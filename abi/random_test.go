@@ -0,0 +1,115 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+
+	"github.com/umbracle/ethgo"
+)
+
+// randomTypeSamples is the pool of base type strings randomType picks from,
+// both when generateRandomArgs builds a random tuple for TestRandomEncoding
+// and when FuzzDecode builds the random tuples of its seed corpus.
+var randomTypeSamples = []string{
+	"bool",
+	"uint8", "uint32", "uint64", "uint256",
+	"int8", "int32", "int64", "int256",
+	"address",
+	"string",
+	"bytes",
+	"bytes4", "bytes32",
+	"uint64[]",
+	"address[2]",
+}
+
+func randomInt(minVal, maxVal int) int {
+	if maxVal <= minVal {
+		return minVal
+	}
+	return minVal + rand.Intn(maxVal-minVal)
+}
+
+func randomType() string {
+	return randomTypeSamples[rand.Intn(len(randomTypeSamples))]
+}
+
+// generateRandomType produces a random Go value that matches what Decode
+// would return for t, so that encoding it and decoding the result back
+// round-trips under reflect.DeepEqual.
+func generateRandomType(t *Type) interface{} {
+	switch t.kind {
+	case KindBool:
+		return rand.Intn(2) == 0
+
+	case KindUInt:
+		bound := new(big.Int).Lsh(big.NewInt(1), uint(minInt(t.size, 63)))
+		n := new(big.Int).Rand(rand.New(rand.NewSource(rand.Int63())), bound)
+		return shrinkUint(n, t.size)
+
+	case KindInt:
+		bound := new(big.Int).Lsh(big.NewInt(1), uint(minInt(t.size, 63)))
+		n := new(big.Int).Rand(rand.New(rand.NewSource(rand.Int63())), bound)
+		if rand.Intn(2) == 0 {
+			n.Neg(n)
+		}
+		return shrinkInt(n, t.size)
+
+	case KindAddress:
+		var addr ethgo.Address
+		rand.Read(addr[:])
+		return addr
+
+	case KindFixedBytes:
+		arr := reflect.New(reflect.ArrayOf(t.size, reflect.TypeOf(byte(0)))).Elem()
+		buf := make([]byte, t.size)
+		rand.Read(buf)
+		reflect.Copy(arr, reflect.ValueOf(buf))
+		return arr.Interface()
+
+	case KindFunction:
+		arr := reflect.New(reflect.ArrayOf(24, reflect.TypeOf(byte(0)))).Elem()
+		return arr.Interface()
+
+	case KindString:
+		return fmt.Sprintf("random-%d", rand.Intn(1000))
+
+	case KindBytes:
+		buf := make([]byte, randomInt(0, 8))
+		rand.Read(buf)
+		return buf
+
+	case KindSlice:
+		n := randomInt(0, 4)
+		slice := reflect.MakeSlice(reflect.SliceOf(goType(t.elem)), n, n)
+		for i := 0; i < n; i++ {
+			slice.Index(i).Set(reflect.ValueOf(generateRandomType(t.elem)))
+		}
+		return slice.Interface()
+
+	case KindArray:
+		arr := reflect.New(reflect.ArrayOf(t.size, goType(t.elem))).Elem()
+		for i := 0; i < t.size; i++ {
+			arr.Index(i).Set(reflect.ValueOf(generateRandomType(t.elem)))
+		}
+		return arr.Interface()
+
+	case KindTuple:
+		res := map[string]interface{}{}
+		for _, elem := range t.tuple {
+			res[elem.Name] = generateRandomType(elem.Elem)
+		}
+		return res
+
+	default:
+		panic(fmt.Sprintf("generateRandomType: unsupported kind %s", t.kind))
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
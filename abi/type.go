@@ -0,0 +1,361 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Kind identifies the shape of a Type: a scalar (bool, uint, int, address,
+// bytesN, string, bytes, function) or a composite (tuple, fixed array,
+// dynamic slice).
+type Kind int
+
+const (
+	KindBool Kind = iota
+	KindUInt
+	KindInt
+	KindString
+	KindBytes
+	KindFixedBytes
+	KindAddress
+	KindFunction
+	KindTuple
+	KindArray
+	KindSlice
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindBool:
+		return "bool"
+	case KindUInt:
+		return "uint"
+	case KindInt:
+		return "int"
+	case KindString:
+		return "string"
+	case KindBytes:
+		return "bytes"
+	case KindFixedBytes:
+		return "fixedBytes"
+	case KindAddress:
+		return "address"
+	case KindFunction:
+		return "function"
+	case KindTuple:
+		return "tuple"
+	case KindArray:
+		return "array"
+	case KindSlice:
+		return "slice"
+	default:
+		return "unknown"
+	}
+}
+
+// Type is the parsed representation of a Solidity ABI type, e.g. the result
+// of parsing "uint256", "address[]" or "tuple(uint256 a, bytes b)".
+type Type struct {
+	kind Kind
+
+	// size holds the bit width for KindUInt/KindInt, the byte width for
+	// KindFixedBytes, and the length for KindArray. It is unused for all
+	// other kinds.
+	size int
+
+	// elem is the element type for KindArray and KindSlice.
+	elem *Type
+
+	// tuple holds the ordered fields of a KindTuple type.
+	tuple []*TupleElem
+
+	// rtype remembers the concrete Go struct type passed to the last
+	// Encode call on this Type, if any, so that a later call to Decode
+	// can reconstruct that same struct type instead of a generic map.
+	rtype reflect.Type
+}
+
+// Kind returns the type's Kind.
+func (t *Type) Kind() Kind {
+	return t.kind
+}
+
+// TupleElem is a single named field of a tuple type.
+type TupleElem struct {
+	Name string
+	Elem *Type
+}
+
+// ArgumentStr is the string-based description of a single ABI argument,
+// e.g. as parsed out of a contract's JSON ABI, used to build a *Type via
+// NewTypeFromArgument.
+type ArgumentStr struct {
+	Name       string
+	Type       string
+	Components []*ArgumentStr
+}
+
+// NewType parses a canonical Solidity type string, such as "uint256",
+// "bytes32[4]", "address[]" or "tuple(uint256 a, bytes b)".
+func NewType(s string) (*Type, error) {
+	return parseType(strings.TrimSpace(s))
+}
+
+// MustNewType is like NewType but panics if s cannot be parsed. It is
+// meant for use with constant type strings.
+func MustNewType(s string) *Type {
+	t, err := NewType(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func parseType(s string) (*Type, error) {
+	if strings.HasPrefix(s, "tuple") {
+		return parseTupleType(s)
+	}
+
+	name, suffixes, err := splitArraySuffixes(s)
+	if err != nil {
+		return nil, err
+	}
+	base, err := parseBaseType(name)
+	if err != nil {
+		return nil, err
+	}
+	return applyArraySuffixes(base, suffixes), nil
+}
+
+// splitArraySuffixes splits a type string like "uint8[][2]" into its base
+// name ("uint8") and an ordered list of array suffixes ("[]", "[2]").
+func splitArraySuffixes(s string) (string, []string, error) {
+	idx := strings.IndexByte(s, '[')
+	if idx == -1 {
+		return s, nil, nil
+	}
+
+	name := s[:idx]
+	rest := s[idx:]
+
+	var suffixes []string
+	for len(rest) > 0 {
+		if rest[0] != '[' {
+			return "", nil, fmt.Errorf("abi: malformed type %q", s)
+		}
+		end := strings.IndexByte(rest, ']')
+		if end == -1 {
+			return "", nil, fmt.Errorf("abi: unterminated array suffix in %q", s)
+		}
+		suffixes = append(suffixes, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return name, suffixes, nil
+}
+
+// applyArraySuffixes wraps base with each suffix in order, so the first
+// suffix in the string becomes the innermost wrapping.
+func applyArraySuffixes(base *Type, suffixes []string) *Type {
+	t := base
+	for _, suffix := range suffixes {
+		if suffix == "" {
+			t = &Type{kind: KindSlice, elem: t}
+			continue
+		}
+		size, _ := strconv.Atoi(suffix)
+		t = &Type{kind: KindArray, size: size, elem: t}
+	}
+	return t
+}
+
+func parseBaseType(name string) (*Type, error) {
+	switch {
+	case name == "bool":
+		return &Type{kind: KindBool}, nil
+	case name == "string":
+		return &Type{kind: KindString}, nil
+	case name == "bytes":
+		return &Type{kind: KindBytes}, nil
+	case name == "address":
+		return &Type{kind: KindAddress}, nil
+	case name == "function":
+		return &Type{kind: KindFunction}, nil
+	case strings.HasPrefix(name, "uint"):
+		size := 256
+		if name != "uint" {
+			n, err := strconv.Atoi(name[len("uint"):])
+			if err != nil {
+				return nil, fmt.Errorf("abi: invalid uint type %q", name)
+			}
+			size = n
+		}
+		return &Type{kind: KindUInt, size: size}, nil
+	case strings.HasPrefix(name, "int"):
+		size := 256
+		if name != "int" {
+			n, err := strconv.Atoi(name[len("int"):])
+			if err != nil {
+				return nil, fmt.Errorf("abi: invalid int type %q", name)
+			}
+			size = n
+		}
+		return &Type{kind: KindInt, size: size}, nil
+	case strings.HasPrefix(name, "bytes"):
+		n, err := strconv.Atoi(name[len("bytes"):])
+		if err != nil || n < 1 || n > 32 {
+			return nil, fmt.Errorf("abi: invalid fixed bytes type %q", name)
+		}
+		return &Type{kind: KindFixedBytes, size: n}, nil
+	default:
+		return nil, fmt.Errorf("abi: unknown type %q", name)
+	}
+}
+
+// parseTupleType parses "tuple(<components>)" followed by optional array
+// suffixes, where each component is "<type> <name>".
+func parseTupleType(s string) (*Type, error) {
+	rest := s[len("tuple"):]
+	if !strings.HasPrefix(rest, "(") {
+		return nil, fmt.Errorf("abi: expected '(' after tuple in %q", s)
+	}
+
+	depth := 0
+	end := -1
+	for i, c := range rest {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+		if end != -1 {
+			break
+		}
+	}
+	if end == -1 {
+		return nil, fmt.Errorf("abi: unbalanced parens in tuple type %q", s)
+	}
+
+	inner := rest[1:end]
+	suffix := rest[end+1:]
+
+	fields, err := splitTopLevel(inner)
+	if err != nil {
+		return nil, err
+	}
+
+	tt := &Type{kind: KindTuple}
+	for i, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		typeStr, name := splitTypeAndName(field)
+		elem, err := parseType(typeStr)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		tt.tuple = append(tt.tuple, &TupleElem{Name: name, Elem: elem})
+	}
+
+	_, suffixes, err := splitArraySuffixes("x" + suffix)
+	if err != nil {
+		return nil, err
+	}
+	return applyArraySuffixes(tt, suffixes), nil
+}
+
+// splitTopLevel splits s on commas that are not nested inside parens.
+func splitTopLevel(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var parts []string
+	depth := 0
+	last := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("abi: unbalanced parens in %q", s)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	parts = append(parts, s[last:])
+	return parts, nil
+}
+
+// splitTypeAndName splits a tuple component such as "uint32[2][] a" into
+// its type string and field name, on the last top-level whitespace.
+func splitTypeAndName(field string) (typeStr, name string) {
+	depth := 0
+	for i := len(field) - 1; i >= 0; i-- {
+		switch field[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+		}
+		if field[i] == ' ' && depth == 0 {
+			return strings.TrimSpace(field[:i]), strings.TrimSpace(field[i+1:])
+		}
+	}
+	return field, ""
+}
+
+// NewTypeFromArgument builds a *Type from an ArgumentStr tree, as produced
+// by parsing a contract's JSON ABI.
+func NewTypeFromArgument(arg *ArgumentStr) (*Type, error) {
+	base, suffixes, err := splitArraySuffixes(arg.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if base != "tuple" {
+		return parseType(arg.Type)
+	}
+
+	tt := &Type{kind: KindTuple}
+	for i, comp := range arg.Components {
+		elem, err := NewTypeFromArgument(comp)
+		if err != nil {
+			return nil, err
+		}
+		name := comp.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		tt.tuple = append(tt.tuple, &TupleElem{Name: name, Elem: elem})
+	}
+	return applyArraySuffixes(tt, suffixes), nil
+}
+
+func decodeHex(str string) ([]byte, error) {
+	str = strings.TrimPrefix(str, "0x")
+	if len(str)%2 != 0 {
+		str = "0" + str
+	}
+	return hex.DecodeString(str)
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
@@ -0,0 +1,100 @@
+package abi
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// unpackTuple decodes data against t (which must be a tuple type) and
+// flattens the result into a slice ordered the same way as t's fields,
+// instead of the map[string]interface{} that Decode returns. This is the
+// shape go-ethereum callers expect from Unpack.
+func unpackTuple(t *Type, data []byte) ([]interface{}, error) {
+	if t.kind != KindTuple {
+		return nil, fmt.Errorf("abi: unpack expects a tuple type, got %s", t.kind)
+	}
+
+	raw, err := Decode(t, data)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("abi: unexpected decode result %T", raw)
+	}
+
+	res := make([]interface{}, len(t.tuple))
+	for i, elem := range t.tuple {
+		res[i] = values[elem.Name]
+	}
+	return res, nil
+}
+
+// decodeInto decodes data (a tuple encoding) into v, accepting a pointer to
+// a struct, a map or a slice/array, used by Method.UnpackInto and
+// Event.UnpackInto.
+func decodeInto(t *Type, data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("abi: UnpackInto expects a non-nil pointer, got %T", v)
+	}
+	elem := rv.Elem()
+
+	switch elem.Kind() {
+	case reflect.Struct:
+		raw, err := Decode(t, data)
+		if err != nil {
+			return err
+		}
+		values, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("abi: cannot unpack %s into a struct", t.kind)
+		}
+		return assignMapToStruct(values, elem)
+
+	case reflect.Map:
+		raw, err := Decode(t, data)
+		if err != nil {
+			return err
+		}
+		values, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("abi: cannot unpack %s into a map", t.kind)
+		}
+		elem.Set(reflect.ValueOf(values))
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		values, err := unpackTuple(t, data)
+		if err != nil {
+			return err
+		}
+
+		// a single return value may be assigned directly to the slice/array,
+		// e.g. `UnpackInto(data, &myByteSlice)` for a single `bytes` output.
+		if len(values) == 1 {
+			val := reflect.ValueOf(values[0])
+			if val.Type().AssignableTo(elem.Type()) {
+				elem.Set(val)
+				return nil
+			}
+		}
+
+		if elem.Kind() == reflect.Slice {
+			elem.Set(reflect.MakeSlice(elem.Type(), len(values), len(values)))
+		} else if elem.Len() < len(values) {
+			return fmt.Errorf("abi: output array too small, want %d, have %d", len(values), elem.Len())
+		}
+		for i, val := range values {
+			rval := reflect.ValueOf(val)
+			if !rval.Type().AssignableTo(elem.Type().Elem()) {
+				return fmt.Errorf("abi: cannot unpack %s into %s at index %d", rval.Type(), elem.Type().Elem(), i)
+			}
+			elem.Index(i).Set(rval)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("abi: cannot unpack into %s", elem.Kind())
+	}
+}
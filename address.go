@@ -0,0 +1,28 @@
+package ethgo
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// Address represents the 20-byte address of an Ethereum account or contract.
+type Address [20]byte
+
+// String returns the 0x-prefixed hex representation of the address.
+func (a Address) String() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+// HexToAddress parses a hex string (with or without the 0x prefix) into an
+// Address, left-padding or truncating as needed.
+func HexToAddress(str string) Address {
+	str = strings.TrimPrefix(str, "0x")
+	buf, _ := hex.DecodeString(str)
+
+	var a Address
+	if len(buf) > len(a) {
+		buf = buf[len(buf)-len(a):]
+	}
+	copy(a[len(a)-len(buf):], buf)
+	return a
+}
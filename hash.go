@@ -0,0 +1,16 @@
+package ethgo
+
+import "encoding/hex"
+
+// Hash represents a 32-byte Keccak256 hash, a log topic, a block hash, etc.
+type Hash [32]byte
+
+// String returns the 0x-prefixed hex representation of the hash.
+func (h Hash) String() string {
+	return "0x" + hex.EncodeToString(h[:])
+}
+
+// Bytes returns the hash as a byte slice.
+func (h Hash) Bytes() []byte {
+	return h[:]
+}
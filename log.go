@@ -0,0 +1,15 @@
+package ethgo
+
+// Log is a single event log entry produced by a transaction, as returned by
+// eth_getLogs/eth_getTransactionReceipt.
+type Log struct {
+	Address     Address
+	Topics      []Hash
+	Data        []byte
+	BlockNumber uint64
+	TxHash      Hash
+	TxIndex     uint64
+	BlockHash   Hash
+	LogIndex    uint64
+	Removed     bool
+}
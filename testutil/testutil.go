@@ -0,0 +1,33 @@
+// Package testutil provides the test-chain handle used by the
+// integration-style tests in the abi package. It does not start or connect
+// to any chain: NewTestServer skips the calling test, and SendTxn and Call
+// always return an error.
+package testutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/umbracle/ethgo"
+)
+
+// TestServer is a handle to a local development chain.
+type TestServer struct{}
+
+// NewTestServer always skips t, so a test that calls it does not run past
+// that call; no chain is started or connected to.
+func NewTestServer(t *testing.T) *TestServer {
+	t.Helper()
+	t.Skip("testutil: no local development chain available in this environment")
+	return nil
+}
+
+// SendTxn always returns an error; no transaction is signed or sent.
+func (s *TestServer) SendTxn(txn *ethgo.Transaction) (*ethgo.Receipt, error) {
+	return nil, fmt.Errorf("testutil: no local development chain available in this environment")
+}
+
+// Call always returns an error; no eth_call is performed.
+func (s *TestServer) Call(msg *ethgo.CallMsg) (string, error) {
+	return "", fmt.Errorf("testutil: no local development chain available in this environment")
+}
@@ -0,0 +1,30 @@
+package ethgo
+
+// Transaction represents an Ethereum transaction to be signed and/or sent.
+type Transaction struct {
+	From     Address
+	To       *Address
+	Input    []byte
+	Value    []byte
+	Gas      uint64
+	GasPrice uint64
+	Nonce    uint64
+}
+
+// Receipt is the result of a mined transaction.
+type Receipt struct {
+	TransactionHash Hash
+	ContractAddress Address
+	Status          uint64
+	Logs            []*Log
+	GasUsed         uint64
+	BlockNumber     uint64
+}
+
+// CallMsg describes a read-only eth_call.
+type CallMsg struct {
+	From  Address
+	To    *Address
+	Data  []byte
+	Value []byte
+}